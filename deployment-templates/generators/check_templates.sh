@@ -0,0 +1,57 @@
+#!/bin/bash
+
+# Starter Template Check
+# Renders every starter template with j2, the way generate.sh does, so Jinja
+# syntax errors (for example a Go composite literal starting with "{{") fail
+# here instead of silently falling back to sed during generation.
+
+set -euo pipefail
+
+# Colors for output
+RED='\033[0;31m'
+GREEN='\033[0;32m'
+NC='\033[0m'
+
+log_info() { echo -e "${GREEN}[INFO]${NC} $1"; }
+log_error() { echo -e "${RED}[ERROR]${NC} $1"; }
+
+SCRIPT_DIR="$(cd "$(dirname "${BASH_SOURCE[0]}")" && pwd)"
+STARTER_DIR="${1:-$SCRIPT_DIR/../service-types/golang/starter}"
+
+if ! command -v j2 >/dev/null 2>&1; then
+    log_error "j2 not found; install it with: pip install j2cli"
+    exit 1
+fi
+
+template_vars='{"service_name":"check-service","app_port":"8080"}'
+out_dir="$(mktemp -d)"
+trap 'rm -rf "$out_dir"' EXIT
+
+failed=0
+checked=0
+while read -r template_file; do
+    relative_path="${template_file#$STARTER_DIR/}"
+    output_file="$out_dir/${relative_path%.j2}"
+    mkdir -p "$(dirname "$output_file")"
+    checked=$((checked + 1))
+    if ! echo "$template_vars" | j2 "$template_file" -f json -o "$output_file"; then
+        log_error "Failed to render: $relative_path"
+        failed=$((failed + 1))
+    fi
+done < <(find "$STARTER_DIR" -name "*.j2" -type f | sort)
+
+if [[ $failed -gt 0 ]]; then
+    log_error "$failed of $checked templates failed to render"
+    exit 1
+fi
+
+# Rendered Go sources must still be valid Go.
+if command -v gofmt >/dev/null 2>&1; then
+    if ! unformatted="$(find "$out_dir" -name "*.go" -exec gofmt -l {} +)" || [[ -n "$unformatted" ]]; then
+        log_error "Rendered Go sources are not valid or not gofmt-clean:"
+        echo "${unformatted//$out_dir\//  }"
+        exit 1
+    fi
+fi
+
+log_info "All $checked templates in $STARTER_DIR render cleanly"